@@ -18,15 +18,38 @@ import (
 )
 
 // List holds the elements in a slice
+//
+// Because List embeds a fixed-size bootstrap array to avoid heap allocations
+// for small lists (see resize), a List must not be copied after its first use
+// (value assignment, storing it in a map/struct field, returning it by value,
+// etc.) — the copy's elements may keep pointing at the original's bootstrap
+// array, and mutating either one can then silently corrupt the other. This
+// is enforced at runtime for Add, Remove, Insert, Set, Swap, Clear,
+// AppendList and InsertList: calling one of them on a copy of a List that has
+// already been used panics instead of corrupting data, mirroring
+// strings.Builder's copy guard. Sort is not guarded: on such a copy it
+// reorders the elements shared with the original. Take or pass *List
+// instead. 使用后不可复制，复制后修改会直接panic
 // 单向链表，不带头结点
 type List struct {
-	elements []interface{}
-	size     int
+	elements  []interface{}
+	size      int
+	version   int            // incremented on every structural modification, used to fail-fast stale iterators
+	bootstrap [8]interface{} // inline backing storage, avoids a heap allocation for the slice while size <= len(bootstrap)
+
+	// viewOf and viewVersion are set when this list is a shared view created
+	// by Slice; see slice.go.
+	viewOf      *List
+	viewVersion int
+
+	policy GrowthPolicy // controls how the backing array grows and shrinks; see growthpolicy.go
+
+	addr *List // of receiver, to detect illegal copies; see copyCheck
 }
 
 const (
-	growthFactor = float32(2.0)  // growth by 100%
-	shrinkFactor = float32(0.25) // shrink when size is 25% of capacity (0 means never shrink)
+	growthFactor = float32(2.0)  // growth by 100%, used by DoublingPolicy
+	shrinkFactor = float32(0.25) // shrink when size is 25% of capacity (0 means never shrink), used by DoublingPolicy
 )
 
 // 用于断言
@@ -34,18 +57,43 @@ func assertListImplementation() {
 	var _ lists.List = (*List)(nil)
 }
 
-// New instantiates a new list and adds the passed values, if any, to the list
-// 实例化List，同时可以增加多个values
+// New instantiates a new list and adds the passed values, if any, to the list.
+// The list grows and shrinks according to DoublingPolicy; use NewWithPolicy
+// for other policies. 实例化List，同时可以增加多个values
 func New(values ...interface{}) *List {
-	list := &List{}
+	list := &List{policy: DoublingPolicy{}}
 	if len(values) > 0 {
 		list.Add(values...)
 	}
 	return list
 }
 
+// NewWithPolicy instantiates a new list that grows and shrinks its backing
+// array according to policy, and adds the passed values, if any, to the list.
+func NewWithPolicy(policy GrowthPolicy, values ...interface{}) *List {
+	list := &List{policy: policy}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// copyCheck panics if list is a copy (by value) of a List that has already
+// been mutated, rather than letting the copy silently alias the original's
+// bootstrap array. Mirrors the guard strings.Builder uses for the same
+// reason. A never-mutated zero-value List can still be freely copied, since
+// copyCheck has not yet bound addr to anything.
+func (list *List) copyCheck() {
+	if list.addr == nil {
+		list.addr = list
+	} else if list.addr != list {
+		panic("arraylist: illegal use of non-zero List copied by value")
+	}
+}
+
 // Add appends a value at the end of the list
 func (list *List) Add(values ...interface{}) {
+	list.copyCheck()
 	list.growBy(len(values))
 	for _, value := range values {
 		// list.size表示当前个数，又因为索引从0开始
@@ -53,6 +101,7 @@ func (list *List) Add(values ...interface{}) {
 		list.elements[list.size] = value
 		list.size++
 	}
+	list.version++
 }
 
 // Get returns the element at index.
@@ -69,17 +118,19 @@ func (list *List) Get(index int) (interface{}, bool) {
 
 // Remove removes the element at the given index from the list.
 func (list *List) Remove(index int) {
+	list.copyCheck()
 	// 先判断索引是否在合法范围
 	if !list.withinRange(index) {
 		return
 	}
 	// 将索引位置值置为nil
-	list.elements[index] = nil                                    // cleanup reference
+	list.elements[index] = nil // cleanup reference
 	// 将索引之后的值向前拷贝
 	copy(list.elements[index:], list.elements[index+1:list.size]) // shift to the left by one (slow operation, need ways to optimize this)
 	list.size--
 	// 压缩的根本也是申请小数组，然后拷贝到数组中
 	list.shrink()
+	list.version++
 }
 
 // Contains checks if elements (one or more) are present in the set.
@@ -87,10 +138,10 @@ func (list *List) Remove(index int) {
 // Performance time complexity of n^2.
 // Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
 func (list *List) Contains(values ...interface{}) bool {
-    // 判断是否包含，多个中任意一个是否包含，其实就是要遍历
+	// 判断是否包含，多个中任意一个是否包含，其实就是要遍历
 	for _, searchValue := range values {
 		found := false
-		for _, element := range list.elements {
+		for _, element := range list.elements[:list.size] {
 			if element == searchValue {
 				found = true
 				break
@@ -112,13 +163,13 @@ func (list *List) Values() []interface{} {
 	return newElements
 }
 
-//IndexOf returns index of provided element
+// IndexOf returns index of provided element
 // 获取对应值所在索引，如果不存在则返回-1
 func (list *List) IndexOf(value interface{}) int {
 	if list.size == 0 {
 		return -1
 	}
-	for index, element := range list.elements {
+	for index, element := range list.elements[:list.size] {
 		if element == value {
 			return index
 		}
@@ -138,11 +189,44 @@ func (list *List) Size() int {
 	return list.size
 }
 
-// Clear removes all elements from the list.
+// Cap returns the current capacity of the list's backing array.
+func (list *List) Cap() int {
+	return cap(list.elements)
+}
+
+// Reserve grows the list's backing array, if necessary, so that it can hold
+// at least capacity elements without further reallocation. It never shrinks
+// the list.
+func (list *List) Reserve(capacity int) {
+	if capacity > cap(list.elements) {
+		list.resize(capacity)
+	}
+}
+
+// TrimToSize shrinks the list's backing array capacity down to its current
+// size, releasing any extra capacity, bypassing the configured GrowthPolicy.
+func (list *List) TrimToSize() {
+	if cap(list.elements) > list.size {
+		list.resize(list.size)
+	}
+}
+
+// Clear removes all elements from the list. It makes no capacity guarantee:
+// in particular it rebinds to the full bootstrap array (capacity
+// len(bootstrap)) regardless of the list's size or configured GrowthPolicy
+// before Clear, re-enabling the allocation-free fast path for whatever is
+// added next. Use TrimToSize for an exact-capacity guarantee.
 // 清空数组列表
 func (list *List) Clear() {
+	list.copyCheck()
+	for i := 0; i < list.size; i++ {
+		list.elements[i] = nil // cleanup references so the GC can collect them
+	}
 	list.size = 0
-	list.elements = []interface{}{}
+	// Rebinding to the bootstrap array both releases any oversized heap backing
+	// array and re-enables the allocation-free fast path for the next Add.
+	list.elements = list.bootstrap[:len(list.bootstrap):len(list.bootstrap)]
+	list.version++
 }
 
 // Sort sorts values (in-place) using.
@@ -158,6 +242,7 @@ func (list *List) Sort(comparator utils.Comparator) {
 // Swap swaps the two values at the specified positions.
 // 将两个索引对应数值对换
 func (list *List) Swap(i, j int) {
+	list.copyCheck()
 	if list.withinRange(i) && list.withinRange(j) {
 		list.elements[i], list.elements[j] = list.elements[j], list.elements[i]
 	}
@@ -167,6 +252,7 @@ func (list *List) Swap(i, j int) {
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
 func (list *List) Insert(index int, values ...interface{}) {
+	list.copyCheck()
 
 	if !list.withinRange(index) {
 		// Append
@@ -183,6 +269,7 @@ func (list *List) Insert(index int, values ...interface{}) {
 	copy(list.elements[index+l:], list.elements[index:list.size-l])
 	// 填充多个values
 	copy(list.elements[index:], values)
+	list.version++
 }
 
 // Set the value at specified index
@@ -190,6 +277,7 @@ func (list *List) Insert(index int, values ...interface{}) {
 // Note: position equal to list's size is valid, i.e. append.
 // 指定索引的值修改为value
 func (list *List) Set(index int, value interface{}) {
+	list.copyCheck()
 
 	if !list.withinRange(index) {
 		// Append
@@ -200,6 +288,7 @@ func (list *List) Set(index int, value interface{}) {
 	}
 
 	list.elements[index] = value
+	list.version++
 }
 
 // String returns a string representation of container
@@ -221,6 +310,18 @@ func (list *List) withinRange(index int) bool {
 }
 
 func (list *List) resize(cap int) {
+	// 如果所需容量能被内置的bootstrap数组容纳，直接复用它，不再申请堆内存
+	// mirrors the Vector.realloc trick from Go's early container/vector.
+	// Sliced to the requested cap, not always the full bootstrap, so that
+	// Cap()/TrimToSize()/a configured GrowthPolicy (e.g. FixedChunkPolicy)
+	// report and honor the exact capacity callers asked for; it's still a
+	// sub-slice of the same inline array either way, so this costs nothing.
+	if cap <= len(list.bootstrap) {
+		newElements := list.bootstrap[:cap:cap]
+		copy(newElements, list.elements)
+		list.elements = newElements
+		return
+	}
 	// 切片扩容，本质就是创建一个新的切片，再拷贝进去
 	// 底层仍然是数组
 	newElements := make([]interface{}, cap, cap)
@@ -229,25 +330,48 @@ func (list *List) resize(cap int) {
 	list.elements = newElements
 }
 
-// Expand the array if necessary, i.e. capacity will be reached if we add n elements
+// growthPolicy returns the list's configured GrowthPolicy, defaulting to
+// DoublingPolicy for lists built without one (e.g. zero-value Lists created
+// internally by Map/Select/Slice).
+func (list *List) growthPolicy() GrowthPolicy {
+	if list.policy == nil {
+		return DoublingPolicy{}
+	}
+	return list.policy
+}
+
+// Expand the array if necessary, i.e. capacity would be exceeded if we add n elements.
+// Filling a list to exactly its current (e.g. Reserve'd) capacity must not trigger a
+// reallocation, hence the strict ">" rather than ">=".
 func (list *List) growBy(n int) {
-	// When capacity is reached, grow by a factor of growthFactor and add number of elements
+	// When capacity is reached, grow according to the list's GrowthPolicy
 	currentCapacity := cap(list.elements)
-	if list.size+n >= currentCapacity {
-		newCapacity := int(growthFactor * float32(currentCapacity+n))
+	if list.size+n > currentCapacity {
+		needed := list.size + n
+		newCapacity := list.growthPolicy().NextCapacity(currentCapacity, n)
+		if newCapacity < needed {
+			// Guard against a buggy or overly conservative policy leaving no room.
+			newCapacity = needed
+		}
+		if needed <= len(list.bootstrap) && newCapacity > len(list.bootstrap) {
+			// Stay within the bootstrap array rather than let the policy
+			// overshoot it and allocate prematurely.
+			newCapacity = len(list.bootstrap)
+		}
 		list.resize(newCapacity)
 	}
 }
 
-// Shrink the array if necessary, i.e. when size is shrinkFactor percent of current capacity
+// Shrink the array if necessary, as decided by the list's GrowthPolicy
 // 缩小数组列表
 func (list *List) shrink() {
-	if shrinkFactor == 0.0 {
+	currentCapacity := cap(list.elements)
+	shouldShrink, newCapacity := list.growthPolicy().ShouldShrink(list.size, currentCapacity)
+	if !shouldShrink {
 		return
 	}
-	// Shrink when size is at shrinkFactor * capacity
-	currentCapacity := cap(list.elements)
-	if list.size <= int(float32(currentCapacity)*shrinkFactor) {
-		list.resize(list.size)
+	if newCapacity < list.size {
+		newCapacity = list.size
 	}
+	list.resize(newCapacity)
 }
@@ -0,0 +1,43 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "testing"
+
+// BenchmarkNewAddSmall creates a short-lived list and adds a handful of
+// elements, the kind of per-request scratch buffer the bootstrap array
+// targets. Run with -benchmem to see the allocation count drop to zero
+// backing-array allocations per iteration once size stays <= len(bootstrap).
+func BenchmarkNewAddSmall(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		list := New()
+		list.Add(1, 2, 3, 4, 5)
+	}
+}
+
+// BenchmarkNewAddExceedsBootstrap adds past the bootstrap capacity, forcing
+// at least one heap allocation for the backing slice, for comparison against
+// BenchmarkNewAddSmall.
+func BenchmarkNewAddExceedsBootstrap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		list := New()
+		for j := 0; j < 32; j++ {
+			list.Add(j)
+		}
+	}
+}
+
+// BenchmarkAddRemoveSmall repeatedly adds and removes within the bootstrap
+// range, exercising growBy/shrink without ever leaving the inline array.
+func BenchmarkAddRemoveSmall(b *testing.B) {
+	list := New()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Add(i)
+		if list.Size() > 4 {
+			list.Remove(0)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "testing"
+
+func TestContainsIgnoresUnusedBootstrapSlots(t *testing.T) {
+	list := New(1, 2, 3)
+	if list.Contains(nil) {
+		t.Fatal("Contains(nil) should be false: nil is not an element of the list, only padding beyond size")
+	}
+}
+
+func TestIndexOfIgnoresUnusedBootstrapSlots(t *testing.T) {
+	list := New(1, 2, 3)
+	if index := list.IndexOf(nil); index != -1 {
+		t.Fatalf("IndexOf(nil) should be -1, got %d", index)
+	}
+}
+
+// TestCopyThenMutatePanics proves that mutating a List that was copied by
+// value after having already been used panics instead of silently aliasing
+// the original's bootstrap array (see the List doc comment).
+func TestCopyThenMutatePanics(t *testing.T) {
+	l1 := New(1, 2, 3)
+	l2 := *l1
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Add on a copied List to panic")
+		}
+	}()
+	l2.Add(99)
+}
+
+// TestUnusedListCanBeCopied proves the copy guard only fires once a List has
+// actually been mutated: a zero-value List that nothing has touched yet may
+// still be freely copied, same as before this List carried an addr field.
+func TestUnusedListCanBeCopied(t *testing.T) {
+	var l1 List
+	l2 := l1
+	l2.Add(1)
+	if l2.Size() != 1 {
+		t.Fatalf("expected size 1, got %d", l2.Size())
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import (
+	"sync"
+
+	"github.com/emirpasic/gods/lists"
+	"github.com/emirpasic/gods/utils"
+)
+
+func assertConcurrentListImplementation() {
+	var _ lists.List = (*Concurrent)(nil)
+}
+
+// Concurrent wraps a *List with a sync.RWMutex so it is safe for use by
+// multiple goroutines. The plain List remains unchanged and lock-free for
+// single-threaded use; reach for Concurrent only when the list is actually
+// shared across goroutines. 并发安全版本，读多写少场景下用RWMutex
+type Concurrent struct {
+	mu   sync.RWMutex
+	list *List
+}
+
+// NewConcurrent instantiates a new concurrency-safe list and adds the passed values, if any, to the list.
+func NewConcurrent(values ...interface{}) *Concurrent {
+	return &Concurrent{list: New(values...)}
+}
+
+// Add appends a value at the end of the list.
+func (c *Concurrent) Add(values ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Add(values...)
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
+func (c *Concurrent) Get(index int) (interface{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Get(index)
+}
+
+// Remove removes the element at the given index from the list.
+func (c *Concurrent) Remove(index int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Remove(index)
+}
+
+// Contains checks if elements (one or more) are present in the set.
+func (c *Concurrent) Contains(values ...interface{}) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Contains(values...)
+}
+
+// Values returns all elements in the list.
+func (c *Concurrent) Values() []interface{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Values()
+}
+
+// IndexOf returns index of provided element.
+func (c *Concurrent) IndexOf(value interface{}) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.IndexOf(value)
+}
+
+// Empty returns true if list does not contain any elements.
+func (c *Concurrent) Empty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Empty()
+}
+
+// Size returns number of elements within the list.
+func (c *Concurrent) Size() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Size()
+}
+
+// Clear removes all elements from the list.
+func (c *Concurrent) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Clear()
+}
+
+// Sort sorts values (in-place) using comparator.
+func (c *Concurrent) Sort(comparator utils.Comparator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Sort(comparator)
+}
+
+// Swap swaps the two values at the specified positions.
+func (c *Concurrent) Swap(i, j int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Swap(i, j)
+}
+
+// Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent elements to the right.
+func (c *Concurrent) Insert(index int, values ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Insert(index, values...)
+}
+
+// Set the value at specified index.
+func (c *Concurrent) Set(index int, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Set(index, value)
+}
+
+// String returns a string representation of container.
+func (c *Concurrent) String() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.String()
+}
+
+// Snapshot returns a copy of the list's values taken under a read lock, safe
+// to use by the caller without further synchronization.
+func (c *Concurrent) Snapshot() []interface{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Values()
+}
+
+// Do locks the list once and runs f against the underlying *List, letting
+// callers batch several operations without paying the per-call lock overhead
+// of the wrapper methods above. f must not retain list beyond the call, and
+// must not itself call back into the Concurrent wrapper (that would deadlock).
+func (c *Concurrent) Do(f func(list *List)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f(c.list)
+}
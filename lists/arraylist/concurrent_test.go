@@ -0,0 +1,100 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrentAddGetSize(t *testing.T) {
+	c := NewConcurrent(1, 2, 3)
+	if c.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", c.Size())
+	}
+	c.Add(4)
+	if value, ok := c.Get(3); !ok || value != 4 {
+		t.Fatalf("expected (4, true), got (%v, %v)", value, ok)
+	}
+}
+
+func TestConcurrentRemoveContainsIndexOf(t *testing.T) {
+	c := NewConcurrent(1, 2, 3)
+	if !c.Contains(2) {
+		t.Fatal("expected list to contain 2")
+	}
+	c.Remove(1)
+	if c.Contains(2) {
+		t.Fatal("did not expect list to contain 2 after Remove")
+	}
+	if index := c.IndexOf(3); index != 1 {
+		t.Fatalf("expected index 1, got %d", index)
+	}
+}
+
+func TestConcurrentInsertSetSwapClear(t *testing.T) {
+	c := NewConcurrent(1, 2, 3)
+	c.Insert(1, 9)
+	if value, _ := c.Get(1); value != 9 {
+		t.Fatalf("expected 9, got %v", value)
+	}
+	c.Set(0, 42)
+	if value, _ := c.Get(0); value != 42 {
+		t.Fatalf("expected 42, got %v", value)
+	}
+	c.Swap(0, 1)
+	if value, _ := c.Get(0); value != 9 {
+		t.Fatalf("expected 9 after swap, got %v", value)
+	}
+	c.Clear()
+	if !c.Empty() {
+		t.Fatal("expected list to be empty after Clear")
+	}
+}
+
+func TestConcurrentSnapshotIsIndependentCopy(t *testing.T) {
+	c := NewConcurrent(1, 2, 3)
+	snapshot := c.Snapshot()
+	c.Add(4)
+	if len(snapshot) != 3 {
+		t.Fatalf("expected snapshot to retain 3 elements, got %d", len(snapshot))
+	}
+}
+
+func TestConcurrentDoBatchesUnderOneLock(t *testing.T) {
+	c := NewConcurrent(1, 2, 3)
+	c.Do(func(list *List) {
+		list.Add(4, 5)
+		list.Remove(0)
+	})
+	if c.Size() != 4 {
+		t.Fatalf("expected size 4, got %d", c.Size())
+	}
+	if value, _ := c.Get(0); value != 2 {
+		t.Fatalf("expected 2, got %v", value)
+	}
+}
+
+// TestConcurrentRace exercises Concurrent from multiple goroutines under
+// -race to confirm the RWMutex actually guards every access to the
+// underlying List.
+func TestConcurrentRace(t *testing.T) {
+	c := NewConcurrent()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c.Add(i)
+			c.Contains(i)
+			c.Values()
+			c.Size()
+		}(i)
+	}
+	wg.Wait()
+	if c.Size() != 50 {
+		t.Fatalf("expected size 50, got %d", c.Size())
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "testing"
+
+func TestEach(t *testing.T) {
+	list := New(1, 2, 3)
+	var sum int
+	list.Each(func(index int, value interface{}) {
+		sum += value.(int)
+	})
+	if sum != 6 {
+		t.Fatalf("expected sum 6, got %d", sum)
+	}
+}
+
+func TestMap(t *testing.T) {
+	list := New(1, 2, 3)
+	doubled := list.Map(func(index int, value interface{}) interface{} {
+		return value.(int) * 2
+	})
+	if doubled.Values()[0] != 2 || doubled.Values()[1] != 4 || doubled.Values()[2] != 6 {
+		t.Fatalf("unexpected Map result: %v", doubled.Values())
+	}
+}
+
+func TestSelect(t *testing.T) {
+	list := New(1, 2, 3, 4)
+	even := list.Select(func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	})
+	if even.Size() != 2 || even.Values()[0] != 2 || even.Values()[1] != 4 {
+		t.Fatalf("unexpected Select result: %v", even.Values())
+	}
+}
+
+func TestAnyAll(t *testing.T) {
+	list := New(1, 2, 3)
+	if !list.Any(func(index int, value interface{}) bool { return value.(int) == 2 }) {
+		t.Fatal("Any should have found 2")
+	}
+	if list.Any(func(index int, value interface{}) bool { return value.(int) == 9 }) {
+		t.Fatal("Any should not have found 9")
+	}
+	if !list.All(func(index int, value interface{}) bool { return value.(int) > 0 }) {
+		t.Fatal("All should be true for all positive values")
+	}
+	if list.All(func(index int, value interface{}) bool { return value.(int) > 1 }) {
+		t.Fatal("All should be false since the first value is not > 1")
+	}
+}
+
+func TestFind(t *testing.T) {
+	list := New(1, 2, 3)
+	index, value := list.Find(func(index int, value interface{}) bool { return value.(int) == 2 })
+	if index != 1 || value != 2 {
+		t.Fatalf("expected (1, 2), got (%d, %v)", index, value)
+	}
+	index, value = list.Find(func(index int, value interface{}) bool { return value.(int) == 9 })
+	if index != -1 || value != nil {
+		t.Fatalf("expected (-1, nil), got (%d, %v)", index, value)
+	}
+}
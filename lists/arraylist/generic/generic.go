@@ -0,0 +1,334 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package generic implements a type-parameterized array list.
+//
+// It mirrors arraylist.List but uses Go type parameters instead of
+// interface{}, avoiding boxing and the allocation/assertion overhead that
+// comes with it. 泛型版本，避免interface{}装箱带来的开销
+//
+// Reference: https://en.wikipedia.org/wiki/List_%28abstract_data_type%29
+package generic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/emirpasic/gods/lists"
+	"github.com/emirpasic/gods/utils"
+)
+
+const (
+	growthFactor = float32(2.0)  // growth by 100%
+	shrinkFactor = float32(0.25) // shrink when size is 25% of capacity (0 means never shrink)
+)
+
+// Comparator compares two values of type T, returning a negative number if
+// a < b, zero if a == b, and a positive number if a > b.
+type Comparator[T any] func(a, b T) int
+
+// List holds the elements in a slice of T.
+// 泛型数组列表，elements本身即为[]T，不再是[]interface{}
+type List[T any] struct {
+	elements []T
+	size     int
+	equal    func(a, b T) bool
+}
+
+// New instantiates a new list and adds the passed values, if any, to the list.
+// equal is used by Contains and IndexOf to compare elements, since the == operator
+// is not defined for every T. equal may be nil if those methods are never called.
+func New[T any](equal func(a, b T) bool, values ...T) *List[T] {
+	list := &List[T]{equal: equal}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// Add appends values at the end of the list.
+func (list *List[T]) Add(values ...T) {
+	list.growBy(len(values))
+	for _, value := range values {
+		list.elements[list.size] = value
+		list.size++
+	}
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
+func (list *List[T]) Get(index int) (T, bool) {
+	if !list.withinRange(index) {
+		var zero T
+		return zero, false
+	}
+	return list.elements[index], true
+}
+
+// Remove removes the element at the given index from the list.
+func (list *List[T]) Remove(index int) {
+	if !list.withinRange(index) {
+		return
+	}
+	var zero T
+	list.elements[index] = zero // cleanup reference so the GC can collect it
+	copy(list.elements[index:], list.elements[index+1:list.size])
+	list.size--
+	list.shrink()
+}
+
+// Contains checks if elements (one or more) are present in the list using the
+// configured equal function. Panics if the list was created without one and
+// a value is searched for in a non-empty list.
+func (list *List[T]) Contains(values ...T) bool {
+	for _, searchValue := range values {
+		found := false
+		for _, element := range list.elements[:list.size] {
+			if list.equal(element, searchValue) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexOf returns index of provided value, or -1 if it is not present.
+func (list *List[T]) IndexOf(value T) int {
+	if list.size == 0 {
+		return -1
+	}
+	for index, element := range list.elements[:list.size] {
+		if list.equal(element, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// Values returns all elements in the list.
+func (list *List[T]) Values() []T {
+	newElements := make([]T, list.size, list.size)
+	copy(newElements, list.elements[:list.size])
+	return newElements
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List[T]) Empty() bool {
+	return list.size == 0
+}
+
+// Size returns number of elements within the list.
+func (list *List[T]) Size() int {
+	return list.size
+}
+
+// Clear removes all elements from the list.
+func (list *List[T]) Clear() {
+	list.size = 0
+	list.elements = []T{}
+}
+
+// Sort sorts values (in-place) using comparator.
+func (list *List[T]) Sort(comparator Comparator[T]) {
+	if list.size < 2 {
+		return
+	}
+	slice := list.elements[:list.size]
+	sort.SliceStable(slice, func(i, j int) bool {
+		return comparator(slice[i], slice[j]) < 0
+	})
+}
+
+// Swap swaps the two values at the specified positions.
+func (list *List[T]) Swap(i, j int) {
+	if list.withinRange(i) && list.withinRange(j) {
+		list.elements[i], list.elements[j] = list.elements[j], list.elements[i]
+	}
+}
+
+// Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent elements to the right.
+// Does not do anything if position is negative or bigger than list's size.
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Insert(index int, values ...T) {
+	if !list.withinRange(index) {
+		if index == list.size {
+			list.Add(values...)
+		}
+		return
+	}
+
+	l := len(values)
+	list.growBy(l)
+	list.size += l
+	copy(list.elements[index+l:], list.elements[index:list.size-l])
+	copy(list.elements[index:], values)
+}
+
+// Set the value at specified index.
+// Does not do anything if position is negative or bigger than list's size.
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Set(index int, value T) {
+	if !list.withinRange(index) {
+		if index == list.size {
+			list.Add(value)
+		}
+		return
+	}
+	list.elements[index] = value
+}
+
+// String returns a string representation of container.
+func (list *List[T]) String() string {
+	str := "ArrayList\n"
+	values := make([]string, 0, list.size)
+	for _, value := range list.elements[:list.size] {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+func (list *List[T]) withinRange(index int) bool {
+	return index >= 0 && index < list.size
+}
+
+func (list *List[T]) resize(cap int) {
+	newElements := make([]T, cap, cap)
+	copy(newElements, list.elements)
+	list.elements = newElements
+}
+
+// growBy expands the array if necessary, i.e. capacity would be exceeded if
+// we add n elements. Filling a list to exactly its current capacity must not
+// trigger a reallocation, hence the strict ">" rather than ">=".
+func (list *List[T]) growBy(n int) {
+	currentCapacity := cap(list.elements)
+	if list.size+n > currentCapacity {
+		newCapacity := int(growthFactor * float32(currentCapacity+n))
+		list.resize(newCapacity)
+	}
+}
+
+// shrink shrinks the array if necessary, i.e. when size is shrinkFactor percent of current capacity.
+func (list *List[T]) shrink() {
+	if shrinkFactor == 0.0 {
+		return
+	}
+	currentCapacity := cap(list.elements)
+	if list.size <= int(float32(currentCapacity)*shrinkFactor) {
+		list.resize(list.size)
+	}
+}
+
+// Adapter wraps a *List[T] so it satisfies the untyped lists.List interface,
+// letting generic lists plug into code written against the interface{} based
+// containers in this repository. 适配器，使泛型List也能满足lists.List接口
+type Adapter[T any] struct {
+	List *List[T]
+}
+
+// NewAdapter wraps list so it satisfies lists.List.
+func NewAdapter[T any](list *List[T]) *Adapter[T] {
+	return &Adapter[T]{List: list}
+}
+
+func assertAdapterImplementation() {
+	var _ lists.List = (*Adapter[any])(nil)
+}
+
+// Add appends values (asserted to T) at the end of the list.
+func (a *Adapter[T]) Add(values ...interface{}) {
+	typed := make([]T, len(values))
+	for i, value := range values {
+		typed[i] = value.(T)
+	}
+	a.List.Add(typed...)
+}
+
+// Get returns the element at index as interface{}.
+func (a *Adapter[T]) Get(index int) (interface{}, bool) {
+	return a.List.Get(index)
+}
+
+// Remove removes the element at the given index from the list.
+func (a *Adapter[T]) Remove(index int) {
+	a.List.Remove(index)
+}
+
+// Contains checks if values (asserted to T) are present in the list.
+func (a *Adapter[T]) Contains(values ...interface{}) bool {
+	typed := make([]T, len(values))
+	for i, value := range values {
+		typed[i] = value.(T)
+	}
+	return a.List.Contains(typed...)
+}
+
+// Values returns all elements in the list as []interface{}.
+func (a *Adapter[T]) Values() []interface{} {
+	values := a.List.Values()
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
+
+// IndexOf returns index of provided value (asserted to T).
+func (a *Adapter[T]) IndexOf(value interface{}) int {
+	return a.List.IndexOf(value.(T))
+}
+
+// Empty returns true if list does not contain any elements.
+func (a *Adapter[T]) Empty() bool {
+	return a.List.Empty()
+}
+
+// Size returns number of elements within the list.
+func (a *Adapter[T]) Size() int {
+	return a.List.Size()
+}
+
+// Clear removes all elements from the list.
+func (a *Adapter[T]) Clear() {
+	a.List.Clear()
+}
+
+// Sort sorts values (in-place) using comparator, adapting the untyped
+// utils.Comparator to the wrapped List[T]'s Comparator[T].
+func (a *Adapter[T]) Sort(comparator utils.Comparator) {
+	a.List.Sort(func(x, y T) int {
+		return comparator(x, y)
+	})
+}
+
+// Swap swaps the two values at the specified positions.
+func (a *Adapter[T]) Swap(i, j int) {
+	a.List.Swap(i, j)
+}
+
+// Insert inserts values (asserted to T) at specified index position shifting
+// the value at that position (if any) and any subsequent elements to the right.
+func (a *Adapter[T]) Insert(index int, values ...interface{}) {
+	typed := make([]T, len(values))
+	for i, value := range values {
+		typed[i] = value.(T)
+	}
+	a.List.Insert(index, typed...)
+}
+
+// Set the value (asserted to T) at specified index.
+func (a *Adapter[T]) Set(index int, value interface{}) {
+	a.List.Set(index, value.(T))
+}
+
+// String returns a string representation of container.
+func (a *Adapter[T]) String() string {
+	return a.List.String()
+}
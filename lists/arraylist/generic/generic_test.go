@@ -0,0 +1,123 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generic
+
+import "testing"
+
+func intEqual(a, b int) bool { return a == b }
+
+func TestAddGetSize(t *testing.T) {
+	list := New(intEqual, 1, 2, 3)
+	if list.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", list.Size())
+	}
+	if value, ok := list.Get(1); !ok || value != 2 {
+		t.Fatalf("expected (2, true), got (%v, %v)", value, ok)
+	}
+	if _, ok := list.Get(3); ok {
+		t.Fatal("expected Get out of range to return false")
+	}
+}
+
+func TestContainsIndexOf(t *testing.T) {
+	list := New(intEqual, 1, 2, 3)
+	if !list.Contains(1, 3) {
+		t.Fatal("expected list to contain 1 and 3")
+	}
+	if list.Contains(4) {
+		t.Fatal("did not expect list to contain 4")
+	}
+	if index := list.IndexOf(2); index != 1 {
+		t.Fatalf("expected index 1, got %d", index)
+	}
+	if index := list.IndexOf(4); index != -1 {
+		t.Fatalf("expected index -1, got %d", index)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	list := New(intEqual, 1, 2, 3)
+	list.Remove(1)
+	if list.Size() != 2 {
+		t.Fatalf("expected size 2, got %d", list.Size())
+	}
+	if value, _ := list.Get(1); value != 3 {
+		t.Fatalf("expected 3, got %v", value)
+	}
+}
+
+func TestInsertSetSwap(t *testing.T) {
+	list := New(intEqual, 1, 2, 3)
+	list.Insert(1, 9)
+	if value, _ := list.Get(1); value != 9 {
+		t.Fatalf("expected 9, got %v", value)
+	}
+	list.Set(0, 42)
+	if value, _ := list.Get(0); value != 42 {
+		t.Fatalf("expected 42, got %v", value)
+	}
+	list.Swap(0, 1)
+	if value, _ := list.Get(0); value != 9 {
+		t.Fatalf("expected 9 after swap, got %v", value)
+	}
+}
+
+func TestGrowByFillsToExactCapacity(t *testing.T) {
+	list := New[int](intEqual)
+	list.resize(4)
+	for i := 0; i < 4; i++ {
+		list.Add(i)
+	}
+	if cap(list.elements) != 4 {
+		t.Fatalf("filling to exactly the current capacity should not reallocate, got cap %d", cap(list.elements))
+	}
+	list.Add(4)
+	if cap(list.elements) <= 4 {
+		t.Fatalf("exceeding capacity should grow, got cap %d", cap(list.elements))
+	}
+}
+
+func TestClear(t *testing.T) {
+	list := New(intEqual, 1, 2, 3)
+	list.Clear()
+	if !list.Empty() {
+		t.Fatal("expected list to be empty after Clear")
+	}
+}
+
+func TestSort(t *testing.T) {
+	list := New(intEqual, 3, 1, 2)
+	list.Sort(func(a, b int) int { return a - b })
+	if list.String() != "ArrayList\n1, 2, 3" {
+		t.Fatalf("unexpected order after Sort: %s", list.String())
+	}
+}
+
+func TestAdapterSatisfiesListsList(t *testing.T) {
+	adapter := NewAdapter(New(intEqual, 1, 2, 3))
+	if adapter.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", adapter.Size())
+	}
+	adapter.Add(4)
+	if !adapter.Contains(4) {
+		t.Fatal("expected adapter to contain 4 after Add")
+	}
+	adapter.Insert(0, 0)
+	if value, _ := adapter.Get(0); value != 0 {
+		t.Fatalf("expected 0, got %v", value)
+	}
+	adapter.Set(0, 100)
+	if value, _ := adapter.Get(0); value != 100 {
+		t.Fatalf("expected 100, got %v", value)
+	}
+	adapter.Remove(0)
+	if adapter.Size() != 4 {
+		t.Fatalf("expected size 4 after Remove, got %d", adapter.Size())
+	}
+	adapter.Clear()
+	if !adapter.Empty() {
+		t.Fatal("expected adapter to be empty after Clear")
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+// GrowthPolicy controls how a List's backing array grows and shrinks as
+// elements are added to and removed from it. Use NewWithPolicy to build a
+// List with a non-default policy. 可插拔的扩缩容策略
+type GrowthPolicy interface {
+	// NextCapacity returns the capacity to grow to when current capacity is
+	// insufficient to hold needed additional elements.
+	NextCapacity(current, needed int) int
+	// ShouldShrink reports whether the backing array should be shrunk given
+	// the list's current size and capacity, and if so, the capacity to
+	// shrink to.
+	ShouldShrink(size, capacity int) (bool, int)
+}
+
+// DoublingPolicy doubles capacity (plus the requested room) on growth and
+// shrinks once size falls to 25% of capacity. This is List's original,
+// hard-coded behavior and remains the default for New.
+type DoublingPolicy struct{}
+
+// NextCapacity implements GrowthPolicy.
+func (DoublingPolicy) NextCapacity(current, needed int) int {
+	return int(growthFactor * float32(current+needed))
+}
+
+// ShouldShrink implements GrowthPolicy.
+func (DoublingPolicy) ShouldShrink(size, capacity int) (bool, int) {
+	if shrinkFactor == 0.0 {
+		return false, 0
+	}
+	if size <= int(float32(capacity)*shrinkFactor) {
+		return true, size
+	}
+	return false, 0
+}
+
+// goldenRatioGrowthFactor approximates the growth factor the Go runtime uses
+// when reallocating large slices on append (~1.25x), rather than doubling.
+const goldenRatioGrowthFactor = float32(1.25)
+
+// GoldenRatioPolicy grows capacity by roughly 1.25x instead of doubling,
+// trading more frequent reallocations for less wasted capacity on lists that
+// grow large. Shrinks the same way as DoublingPolicy.
+type GoldenRatioPolicy struct{}
+
+// NextCapacity implements GrowthPolicy.
+func (GoldenRatioPolicy) NextCapacity(current, needed int) int {
+	next := int(goldenRatioGrowthFactor * float32(current))
+	if min := current + needed; next < min {
+		next = min
+	}
+	return next
+}
+
+// ShouldShrink implements GrowthPolicy.
+func (GoldenRatioPolicy) ShouldShrink(size, capacity int) (bool, int) {
+	return DoublingPolicy{}.ShouldShrink(size, capacity)
+}
+
+// NoShrinkPolicy grows the same way as DoublingPolicy but never shrinks the
+// backing array once grown, trading memory for avoiding reallocation churn on
+// lists that repeatedly grow and shrink around the same size.
+type NoShrinkPolicy struct{}
+
+// NextCapacity implements GrowthPolicy.
+func (NoShrinkPolicy) NextCapacity(current, needed int) int {
+	return DoublingPolicy{}.NextCapacity(current, needed)
+}
+
+// ShouldShrink implements GrowthPolicy.
+func (NoShrinkPolicy) ShouldShrink(size, capacity int) (bool, int) {
+	return false, 0
+}
+
+// fixedChunkPolicy grows capacity in fixed-size increments, useful when
+// allocation sizes should be predictable rather than exponential. Construct
+// one with FixedChunkPolicy.
+type fixedChunkPolicy struct {
+	chunkSize int
+}
+
+// FixedChunkPolicy returns a GrowthPolicy that grows capacity in increments
+// of chunkSize elements. Shrinks the same way as DoublingPolicy. Panics if
+// chunkSize is not positive.
+func FixedChunkPolicy(chunkSize int) GrowthPolicy {
+	if chunkSize <= 0 {
+		panic("arraylist: FixedChunkPolicy chunkSize must be positive")
+	}
+	return fixedChunkPolicy{chunkSize: chunkSize}
+}
+
+// NextCapacity implements GrowthPolicy.
+func (p fixedChunkPolicy) NextCapacity(current, needed int) int {
+	total := current + needed
+	// Ceiling division: an exact multiple of chunkSize must not round up to
+	// an extra, unnecessary chunk.
+	chunks := (total + p.chunkSize - 1) / p.chunkSize
+	return chunks * p.chunkSize
+}
+
+// ShouldShrink implements GrowthPolicy.
+func (p fixedChunkPolicy) ShouldShrink(size, capacity int) (bool, int) {
+	return DoublingPolicy{}.ShouldShrink(size, capacity)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "testing"
+
+func TestReserveExactFill(t *testing.T) {
+	list := New()
+	list.Reserve(10)
+	if list.Cap() != 10 {
+		t.Fatalf("expected Cap() 10 after Reserve(10), got %d", list.Cap())
+	}
+
+	for i := 0; i < 10; i++ {
+		list.Add(i)
+	}
+	if list.Cap() != 10 {
+		t.Fatalf("filling to exactly the reserved capacity should not reallocate, got Cap() %d", list.Cap())
+	}
+
+	list.Add(10)
+	if list.Cap() <= 10 {
+		t.Fatalf("exceeding capacity should grow, got Cap() %d", list.Cap())
+	}
+}
+
+func TestFixedChunkPolicyHonorsChunkSizeBelowBootstrap(t *testing.T) {
+	list := NewWithPolicy(FixedChunkPolicy(2))
+	list.Add(1, 2)
+	if list.Cap() != 2 {
+		t.Fatalf("expected Cap() 2 for FixedChunkPolicy(2), got %d (resize must not floor to the bootstrap's full capacity)", list.Cap())
+	}
+}
+
+func TestFixedChunkPolicyNextCapacityExactMultiple(t *testing.T) {
+	list := NewWithPolicy(FixedChunkPolicy(4))
+	list.Add(make([]interface{}, 12)...)
+	if list.Cap() != 12 {
+		t.Fatalf("expected Cap() 12 for an exact multiple of chunk size 4, got %d", list.Cap())
+	}
+}
+
+func TestTrimToSizeBelowBootstrap(t *testing.T) {
+	list := New(1, 2, 3)
+	list.TrimToSize()
+	if list.Cap() != 3 {
+		t.Fatalf("expected TrimToSize to shrink to exact size 3, got Cap() %d", list.Cap())
+	}
+}
+
+func TestFixedChunkPolicyPanicsOnNonPositive(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected FixedChunkPolicy(0) to panic")
+		}
+	}()
+	FixedChunkPolicy(0)
+}
+
+func TestNoShrinkPolicyNeverShrinks(t *testing.T) {
+	list := NewWithPolicy(NoShrinkPolicy{})
+	for i := 0; i < 20; i++ {
+		list.Add(i)
+	}
+	capBefore := list.Cap()
+	for i := 0; i < 18; i++ {
+		list.Remove(0)
+	}
+	if list.Cap() != capBefore {
+		t.Fatalf("NoShrinkPolicy should never shrink: before=%d after=%d", capBefore, list.Cap())
+	}
+}
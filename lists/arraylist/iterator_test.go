@@ -0,0 +1,78 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "testing"
+
+func TestIteratorNextOnEmpty(t *testing.T) {
+	list := New()
+	it := list.Iterator()
+	if it.Next() {
+		t.Errorf("should not have next on empty list")
+	}
+}
+
+func TestIteratorNextPrev(t *testing.T) {
+	list := New("a", "b", "c")
+	it := list.Iterator()
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Value().(string))
+	}
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Fatalf("unexpected forward traversal: %v", got)
+	}
+
+	got = nil
+	for it.Prev() {
+		got = append(got, it.Value().(string))
+	}
+	if len(got) != 3 || got[0] != "c" || got[1] != "b" || got[2] != "a" {
+		t.Fatalf("unexpected reverse traversal: %v", got)
+	}
+}
+
+func TestIteratorFirstLast(t *testing.T) {
+	list := New(1, 2, 3)
+	it := list.Iterator()
+
+	if !it.First() || it.Value() != 1 {
+		t.Fatalf("First() should land on first element")
+	}
+	if !it.Last() || it.Value() != 3 {
+		t.Fatalf("Last() should land on last element")
+	}
+}
+
+func TestIteratorNextToPrevTo(t *testing.T) {
+	list := New(1, 2, 3, 4, 5)
+	it := list.Iterator()
+
+	even := func(index int, value interface{}) bool {
+		return value.(int)%2 == 0
+	}
+	if !it.NextTo(even) || it.Value() != 2 {
+		t.Fatalf("NextTo should land on first even value, got %v", it.Value())
+	}
+	if !it.PrevTo(func(index int, value interface{}) bool { return true }) || it.Value() != 1 {
+		t.Fatalf("PrevTo should land on the element before the current one, got %v", it.Value())
+	}
+}
+
+func TestIteratorFailFastOnModification(t *testing.T) {
+	list := New(1, 2, 3)
+	it := list.Iterator()
+	it.Next()
+
+	list.Add(4)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Next() to panic after structural modification")
+		}
+	}()
+	it.Next()
+}
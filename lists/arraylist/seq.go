@@ -0,0 +1,37 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "iter"
+
+// Entries returns a Go 1.23 range-over-func iterator over (index, value) pairs,
+// so callers can write `for i, v := range list.Entries() { ... }`.
+// Named Entries rather than All to avoid colliding with the existing
+// predicate-based All(f) bool enumerable method.
+func (list *List) Entries() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		it := list.Iterator()
+		for it.Next() {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// ValueSeq returns a Go 1.23 range-over-func iterator over the list's values,
+// so callers can write `for v := range list.ValueSeq() { ... }`.
+// Named ValueSeq rather than Values to avoid colliding with the existing
+// Values() []interface{} method.
+func (list *List) ValueSeq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		it := list.Iterator()
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "testing"
+
+func TestEntries(t *testing.T) {
+	list := New("a", "b", "c")
+
+	var indexes []int
+	var values []string
+	for i, v := range list.Entries() {
+		indexes = append(indexes, i)
+		values = append(values, v.(string))
+	}
+
+	if len(indexes) != 3 || indexes[0] != 0 || indexes[1] != 1 || indexes[2] != 2 {
+		t.Fatalf("unexpected indexes: %v", indexes)
+	}
+	if len(values) != 3 || values[0] != "a" || values[1] != "b" || values[2] != "c" {
+		t.Fatalf("unexpected values: %v", values)
+	}
+}
+
+func TestEntriesEarlyBreak(t *testing.T) {
+	list := New(1, 2, 3, 4)
+
+	var seen []int
+	for i, v := range list.Entries() {
+		seen = append(seen, v.(int))
+		if i == 1 {
+			break
+		}
+	}
+	if len(seen) != 2 {
+		t.Fatalf("expected iteration to stop after break, got %v", seen)
+	}
+}
+
+func TestValueSeq(t *testing.T) {
+	list := New(1, 2, 3)
+
+	var sum int
+	for v := range list.ValueSeq() {
+		sum += v.(int)
+	}
+	if sum != 6 {
+		t.Fatalf("expected sum 6, got %d", sum)
+	}
+}
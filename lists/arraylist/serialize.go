@@ -0,0 +1,124 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"io"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the list as a plain JSON
+// array of its values rather than exposing internal struct fields.
+func (list *List) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.elements[:list.size])
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the list's contents
+// with the elements of the JSON array in data.
+func (list *List) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	list.reset(values)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. Concrete element types must be
+// registered with gob.Register before encoding/decoding a list containing
+// them, same as any other gob-encoded interface{} value.
+func (list *List) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(list.elements[:list.size]); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, replacing the list's contents with the
+// decoded elements.
+func (list *List) GobDecode(data []byte) error {
+	var values []interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	list.reset(values)
+	return nil
+}
+
+// WriteTo writes the list to w as a varint length prefix followed by its
+// gob-encoded elements, implementing io.WriterTo. The result can be read back
+// with ReadFrom.
+func (list *List) WriteTo(w io.Writer) (int64, error) {
+	payload, err := list.GobEncode()
+	if err != nil {
+		return 0, err
+	}
+
+	var lengthPrefix [binary.MaxVarintLen64]byte
+	prefixLen := binary.PutUvarint(lengthPrefix[:], uint64(len(payload)))
+
+	written, err := w.Write(lengthPrefix[:prefixLen])
+	if err != nil {
+		return int64(written), err
+	}
+	n, err := w.Write(payload)
+	return int64(written + n), err
+}
+
+// ReadFrom reads a list previously written by WriteTo from r, replacing the
+// list's contents, and implements io.ReaderFrom. It reserves exact capacity
+// for the incoming elements up front rather than growing into them via the
+// list's configured GrowthPolicy.
+func (list *List) ReadFrom(r io.Reader) (int64, error) {
+	cr := &countingByteReader{r: r}
+	length, err := binary.ReadUvarint(cr)
+	if err != nil {
+		return cr.n, err
+	}
+
+	payload := make([]byte, length)
+	read, err := io.ReadFull(r, payload)
+	total := cr.n + int64(read)
+	if err != nil {
+		return total, err
+	}
+
+	if err := list.GobDecode(payload); err != nil {
+		return total, err
+	}
+	return total, nil
+}
+
+// reset replaces the list's contents with values, reserving exact capacity
+// for them up front. Uses resize directly rather than Reserve: Clear always
+// rebinds to the full bootstrap array, so Reserve (which only grows) would
+// leave that capacity in place for len(values) <= len(bootstrap) instead of
+// trimming down to it.
+func (list *List) reset(values []interface{}) {
+	list.Clear()
+	list.resize(len(values))
+	list.Add(values...)
+}
+
+// countingByteReader adapts an io.Reader to io.ByteReader for
+// binary.ReadUvarint, tracking exactly how many bytes have been consumed
+// from the underlying reader (unlike bufio.Reader, it never reads ahead).
+type countingByteReader struct {
+	r   io.Reader
+	n   int64
+	buf [1]byte
+}
+
+func (c *countingByteReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(c.r, c.buf[:]); err != nil {
+		return 0, err
+	}
+	c.n++
+	return c.buf[0], nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+func init() {
+	gob.Register(0)
+}
+
+func TestJSONRoundtripReservesExactCapacity(t *testing.T) {
+	values := make([]interface{}, 10)
+	for i := range values {
+		values[i] = i
+	}
+	data, err := json.Marshal(New(values...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := New()
+	if err := json.Unmarshal(data, list); err != nil {
+		t.Fatal(err)
+	}
+	if list.Size() != 10 {
+		t.Fatalf("expected size 10, got %d", list.Size())
+	}
+	if list.Cap() != 10 {
+		t.Fatalf("UnmarshalJSON should reserve exact capacity, got Cap() %d", list.Cap())
+	}
+}
+
+func TestJSONRoundtripReservesExactCapacityBelowBootstrap(t *testing.T) {
+	values := make([]interface{}, 3)
+	for i := range values {
+		values[i] = i
+	}
+	data, err := json.Marshal(New(values...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := New()
+	if err := json.Unmarshal(data, list); err != nil {
+		t.Fatal(err)
+	}
+	if list.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", list.Size())
+	}
+	if list.Cap() != 3 {
+		t.Fatalf("UnmarshalJSON should reserve exact capacity even below the bootstrap's full capacity, got Cap() %d", list.Cap())
+	}
+}
+
+func TestWriteToReadFromReservesExactCapacity(t *testing.T) {
+	values := make([]interface{}, 10)
+	for i := range values {
+		values[i] = i
+	}
+	var buf bytes.Buffer
+	if _, err := New(values...).WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	list := New()
+	n, err := list.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n <= 0 {
+		t.Fatalf("expected ReadFrom to report bytes consumed, got %d", n)
+	}
+	if list.Size() != 10 {
+		t.Fatalf("expected size 10, got %d", list.Size())
+	}
+	if list.Cap() != 10 {
+		t.Fatalf("ReadFrom should reserve exact capacity, got Cap() %d", list.Cap())
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+// Slice returns a view over list's elements in the half-open range [low, high),
+// sharing the same backing array as list rather than copying it.
+//
+// The view is built with the three-index slice form elements[low:high:high], so
+// its capacity equals its length: the first Add/Insert/Set past the view's own
+// bounds cannot silently clobber list's elements beyond high, since growBy will
+// always see the view's capacity is exhausted and allocate a fresh backing
+// array (copy-on-write) instead of writing into list's array.
+//
+// That said, writes to indexes the view and list still share (e.g. Set on the
+// view before it has grown past its capacity) mutate the same memory and are
+// visible through both. Structurally modifying list (Add/Remove/Insert/Set/
+// Clear) after taking the view can also shift or invalidate the data the view
+// is reading. Use Stale to detect the latter, and SliceCopy if this list will
+// outlive independent mutation of the source. 共享底层数组的视图，使用时需注意该陷阱
+func (list *List) Slice(low, high int) *List {
+	if low < 0 || high > list.size || low > high {
+		panic("arraylist: slice index out of range")
+	}
+	return &List{
+		elements:    list.elements[low:high:high],
+		size:        high - low,
+		viewOf:      list,
+		viewVersion: list.version,
+	}
+}
+
+// SliceCopy returns a new list holding a copy of list's elements in the
+// half-open range [low, high). Unlike Slice, the result shares no state with
+// list and is unaffected by later modifications to it.
+func (list *List) SliceCopy(low, high int) *List {
+	if low < 0 || high > list.size || low > high {
+		panic("arraylist: slice index out of range")
+	}
+	copyList := &List{}
+	copyList.Add(list.elements[low:high]...)
+	return copyList
+}
+
+// Stale reports whether list was created by Slice and its source has since
+// been structurally modified (Add/Remove/Insert/Set/Clear), meaning the data
+// list is reading may no longer correspond to the source's current elements.
+// Always false for lists not created by Slice.
+func (list *List) Stale() bool {
+	return list.viewOf != nil && list.viewOf.version != list.viewVersion
+}
+
+// AppendList appends all elements of other to the end of the list, splicing
+// them in with copy rather than one-by-one through Add.
+func (list *List) AppendList(other *List) {
+	list.copyCheck()
+	if other == nil || other.size == 0 {
+		return
+	}
+	list.growBy(other.size)
+	copy(list.elements[list.size:], other.elements[:other.size])
+	list.size += other.size
+	list.version++
+}
+
+// InsertList inserts all elements of other at specified index position,
+// shifting the value at that position (if any) and any subsequent elements
+// to the right, splicing them in with copy rather than one-by-one through Insert.
+// Does not do anything if position is negative or bigger than list's size.
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List) InsertList(index int, other *List) {
+	list.copyCheck()
+	if other == nil || other.size == 0 {
+		return
+	}
+	if !list.withinRange(index) {
+		if index == list.size {
+			list.AppendList(other)
+		}
+		return
+	}
+
+	l := other.size
+	list.growBy(l)
+	list.size += l
+	copy(list.elements[index+l:], list.elements[index:list.size-l])
+	copy(list.elements[index:], other.elements[:l])
+	list.version++
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "testing"
+
+func TestSliceSharesBackingArray(t *testing.T) {
+	list := New(1, 2, 3, 4, 5)
+	view := list.Slice(1, 4)
+	if view.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", view.Size())
+	}
+	if value, _ := view.Get(0); value != 2 {
+		t.Fatalf("expected 2, got %v", value)
+	}
+
+	view.Set(0, 99)
+	if value, _ := list.Get(1); value != 99 {
+		t.Fatalf("expected Set on the view to be visible through the source list, got %v", value)
+	}
+}
+
+func TestSliceOutOfRangePanics(t *testing.T) {
+	list := New(1, 2, 3)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected out-of-range Slice to panic")
+		}
+	}()
+	list.Slice(0, 4)
+}
+
+func TestSliceCopyIsIndependent(t *testing.T) {
+	list := New(1, 2, 3, 4, 5)
+	independent := list.SliceCopy(1, 4)
+
+	independent.Set(0, 99)
+	if value, _ := list.Get(1); value != 2 {
+		t.Fatalf("SliceCopy must not share state with the source, got %v", value)
+	}
+
+	list.Set(1, -1)
+	if value, _ := independent.Get(0); value != 99 {
+		t.Fatalf("modifying the source must not affect a SliceCopy, got %v", value)
+	}
+}
+
+func TestStale(t *testing.T) {
+	list := New(1, 2, 3, 4, 5)
+	view := list.Slice(1, 4)
+	if view.Stale() {
+		t.Fatal("expected a freshly taken view to not be stale")
+	}
+
+	list.Add(6)
+	if !view.Stale() {
+		t.Fatal("expected the view to be stale after the source was structurally modified")
+	}
+}
+
+func TestStaleAlwaysFalseForNonViews(t *testing.T) {
+	list := New(1, 2, 3)
+	list.Add(4)
+	if list.Stale() {
+		t.Fatal("expected Stale to always be false for a list not created by Slice")
+	}
+}
+
+func TestAppendList(t *testing.T) {
+	list := New(1, 2, 3)
+	other := New(4, 5)
+	list.AppendList(other)
+	if list.Size() != 5 {
+		t.Fatalf("expected size 5, got %d", list.Size())
+	}
+	if value, _ := list.Get(4); value != 5 {
+		t.Fatalf("expected 5, got %v", value)
+	}
+}
+
+func TestAppendListNilOrEmptyIsNoop(t *testing.T) {
+	list := New(1, 2, 3)
+	list.AppendList(nil)
+	list.AppendList(New())
+	if list.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", list.Size())
+	}
+}
+
+func TestInsertList(t *testing.T) {
+	list := New(1, 2, 5)
+	other := New(3, 4)
+	list.InsertList(2, other)
+	if list.Size() != 5 {
+		t.Fatalf("expected size 5, got %d", list.Size())
+	}
+	for i, want := range []interface{}{1, 2, 3, 4, 5} {
+		if value, _ := list.Get(i); value != want {
+			t.Fatalf("at index %d: expected %v, got %v", i, want, value)
+		}
+	}
+}
+
+func TestInsertListAtSizeAppends(t *testing.T) {
+	list := New(1, 2)
+	other := New(3, 4)
+	list.InsertList(list.Size(), other)
+	if list.Size() != 4 {
+		t.Fatalf("expected size 4, got %d", list.Size())
+	}
+	if value, _ := list.Get(3); value != 4 {
+		t.Fatalf("expected 4, got %v", value)
+	}
+}